@@ -0,0 +1,27 @@
+// Package audio provides StreamSource implementations for serving audio to Alexa's
+// AudioPlayer interface.
+package audio
+
+import (
+	"context"
+
+	"github.com/mctofu/alexa-skill/alexa"
+)
+
+// StreamSource produces a playable Stream for the audio identified by token.
+type StreamSource interface {
+	Stream(ctx context.Context, token string) (*alexa.Stream, error)
+}
+
+// NearlyFinishedDirective re-signs the stream for token and returns an AudioPlayer.Play
+// directive with ENQUEUE behavior, the recommended response to an
+// AudioPlayer.PlaybackNearlyFinished request so that playback continues seamlessly past
+// the expiry of a previously issued stream URL.
+func NearlyFinishedDirective(ctx context.Context, source StreamSource, token string) ([]alexa.Directive, error) {
+	stream, err := source.Stream(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return []alexa.Directive{alexa.Play(alexa.PlayBehaviorEnqueue, *stream, nil)}, nil
+}
@@ -0,0 +1,53 @@
+package audio
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/mctofu/alexa-skill/alexa"
+)
+
+// DefaultPresignExpires is the default lifetime of a presigned stream URL returned by S3Source.
+const DefaultPresignExpires = 15 * time.Minute
+
+// S3Source is a StreamSource backed by objects in a S3 bucket, addressed by token as the
+// object key. It returns a time-limited presigned GET url so Alexa devices can fetch the
+// object directly from S3 without requiring a long-lived public ACL.
+type S3Source struct {
+	Presigner *s3.PresignClient
+	Bucket    string
+	Expires   time.Duration
+}
+
+// NewS3Source returns a S3Source that presigns GET requests for objects in bucket using
+// presigner, each valid for DefaultPresignExpires.
+func NewS3Source(presigner *s3.PresignClient, bucket string) *S3Source {
+	return &S3Source{
+		Presigner: presigner,
+		Bucket:    bucket,
+		Expires:   DefaultPresignExpires,
+	}
+}
+
+// Stream returns a Stream for the object keyed by token with a presigned GET url.
+func (s *S3Source) Stream(ctx context.Context, token string) (*alexa.Stream, error) {
+	expires := s.Expires
+	if expires <= 0 {
+		expires = DefaultPresignExpires
+	}
+
+	req, err := s.Presigner.PresignGetObject(ctx, &s3.GetObjectInput{
+		Bucket: &s.Bucket,
+		Key:    &token,
+	}, s3.WithPresignExpires(expires))
+	if err != nil {
+		return nil, fmt.Errorf("failed to presign stream url for %s: %v", token, err)
+	}
+
+	return &alexa.Stream{
+		Token: token,
+		URL:   req.URL,
+	}, nil
+}
@@ -15,3 +15,52 @@ func SSMLSpeech(ssml string) *OutputSpeech {
 		SSML: ssml,
 	}
 }
+
+// PermissionsConsentCard returns a Card asking the user to grant the given permissions,
+// e.g. "read::alexa:device:all:address".
+func PermissionsConsentCard(permissions ...string) *Card {
+	return &Card{
+		Type:        "AskForPermissionsConsent",
+		Permissions: permissions,
+	}
+}
+
+// PlayBehavior values for an AudioPlayer.Play directive.
+const (
+	PlayBehaviorReplaceAll      = "REPLACE_ALL"
+	PlayBehaviorEnqueue         = "ENQUEUE"
+	PlayBehaviorReplaceEnqueued = "REPLACE_ENQUEUED"
+)
+
+// ClearBehavior values for an AudioPlayer.ClearQueue directive.
+const (
+	ClearBehaviorEnqueued = "CLEAR_ENQUEUED"
+	ClearBehaviorAll      = "CLEAR_ALL"
+)
+
+// Play returns an AudioPlayer.Play directive that plays stream according to behavior.
+// metadata is optional and populates the display shown by devices with a screen.
+func Play(behavior string, stream Stream, metadata *StreamMetadata) Directive {
+	return Directive{
+		Type:         "AudioPlayer.Play",
+		PlayBehavior: behavior,
+		AudioItem: &AudioItem{
+			Stream:   &stream,
+			Metadata: metadata,
+		},
+	}
+}
+
+// Stop returns an AudioPlayer.Stop directive that stops the currently playing stream.
+func Stop() Directive {
+	return Directive{Type: "AudioPlayer.Stop"}
+}
+
+// ClearQueue returns an AudioPlayer.ClearQueue directive that clears the playback queue
+// according to behavior.
+func ClearQueue(behavior string) Directive {
+	return Directive{
+		Type:          "AudioPlayer.ClearQueue",
+		ClearBehavior: behavior,
+	}
+}
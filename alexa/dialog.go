@@ -0,0 +1,83 @@
+package alexa
+
+// ERSuccessMatch is the status code returned in a slot's Resolutions when an entity
+// resolution authority found a matching value.
+const ERSuccessMatch = "ER_SUCCESS_MATCH"
+
+// DelegateDialog returns a Response that hands control of the next dialog turn back to
+// Alexa's own dialog model, optionally applying updates to the intent first.
+func DelegateDialog(updatedIntent *Intent) *Response {
+	return &Response{
+		Directives: &[]Directive{
+			{
+				Type:          "Dialog.Delegate",
+				UpdatedIntent: updatedIntent,
+			},
+		},
+	}
+}
+
+// ElicitSlot returns a Response that prompts the user for the value of slot.
+func ElicitSlot(slot string, prompt, reprompt *OutputSpeech) *Response {
+	return &Response{
+		OutputSpeech: prompt,
+		Reprompt:     repromptFor(reprompt),
+		Directives: &[]Directive{
+			{
+				Type:         "Dialog.ElicitSlot",
+				SlotToElicit: slot,
+			},
+		},
+	}
+}
+
+// ConfirmSlot returns a Response that asks the user to confirm the value of slot.
+func ConfirmSlot(slot string, prompt, reprompt *OutputSpeech) *Response {
+	return &Response{
+		OutputSpeech: prompt,
+		Reprompt:     repromptFor(reprompt),
+		Directives: &[]Directive{
+			{
+				Type:          "Dialog.ConfirmSlot",
+				SlotToConfirm: slot,
+			},
+		},
+	}
+}
+
+// ConfirmIntent returns a Response that asks the user to confirm the intent as a whole.
+func ConfirmIntent(prompt, reprompt *OutputSpeech) *Response {
+	return &Response{
+		OutputSpeech: prompt,
+		Reprompt:     repromptFor(reprompt),
+		Directives: &[]Directive{
+			{Type: "Dialog.ConfirmIntent"},
+		},
+	}
+}
+
+// repromptFor wraps reprompt in a Reprompt, or returns nil if reprompt is nil so the
+// response omits an empty reprompt object rather than sending an invalid one.
+func repromptFor(reprompt *OutputSpeech) *Reprompt {
+	if reprompt == nil {
+		return nil
+	}
+	return &Reprompt{OutputSpeech: reprompt}
+}
+
+// ResolveSlot returns the first ER_SUCCESS_MATCH value for slot, checking each configured
+// resolution authority in order. ok is false if slot has no successful resolution match.
+func ResolveSlot(slot IntentSlot) (value ResolutionValueData, ok bool) {
+	if slot.Resolutions == nil {
+		return ResolutionValueData{}, false
+	}
+
+	for _, authority := range slot.Resolutions.ResolutionsPerAuthority {
+		if authority.Status.Code != ERSuccessMatch || len(authority.Values) == 0 {
+			continue
+		}
+		return authority.Values[0].Value, true
+	}
+
+	return ResolutionValueData{}, false
+}
@@ -0,0 +1,82 @@
+package alexa
+
+import "testing"
+
+func TestResolveSlot(t *testing.T) {
+	tests := []struct {
+		name    string
+		slot    IntentSlot
+		wantOK  bool
+		wantVal ResolutionValueData
+	}{
+		{
+			name:   "no resolutions",
+			slot:   IntentSlot{Name: "city"},
+			wantOK: false,
+		},
+		{
+			name: "no successful authority",
+			slot: IntentSlot{
+				Resolutions: &Resolutions{
+					ResolutionsPerAuthority: []ResolutionPerAuthority{
+						{Status: ResolutionStatus{Code: "ER_SUCCESS_NO_MATCH"}},
+					},
+				},
+			},
+			wantOK: false,
+		},
+		{
+			name: "matching authority with no values is skipped",
+			slot: IntentSlot{
+				Resolutions: &Resolutions{
+					ResolutionsPerAuthority: []ResolutionPerAuthority{
+						{Status: ResolutionStatus{Code: ERSuccessMatch}},
+						{
+							Status: ResolutionStatus{Code: ERSuccessMatch},
+							Values: []ResolutionValue{
+								{Value: ResolutionValueData{Name: "Seattle", ID: "SEA"}},
+							},
+						},
+					},
+				},
+			},
+			wantOK:  true,
+			wantVal: ResolutionValueData{Name: "Seattle", ID: "SEA"},
+		},
+		{
+			name: "first matching authority wins",
+			slot: IntentSlot{
+				Resolutions: &Resolutions{
+					ResolutionsPerAuthority: []ResolutionPerAuthority{
+						{
+							Status: ResolutionStatus{Code: ERSuccessMatch},
+							Values: []ResolutionValue{
+								{Value: ResolutionValueData{Name: "Portland", ID: "PDX"}},
+							},
+						},
+						{
+							Status: ResolutionStatus{Code: ERSuccessMatch},
+							Values: []ResolutionValue{
+								{Value: ResolutionValueData{Name: "Seattle", ID: "SEA"}},
+							},
+						},
+					},
+				},
+			},
+			wantOK:  true,
+			wantVal: ResolutionValueData{Name: "Portland", ID: "PDX"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ResolveSlot(tt.slot)
+			if ok != tt.wantOK {
+				t.Fatalf("ResolveSlot() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && got != tt.wantVal {
+				t.Errorf("ResolveSlot() = %+v, want %+v", got, tt.wantVal)
+			}
+		})
+	}
+}
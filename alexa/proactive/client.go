@@ -0,0 +1,84 @@
+// Package proactive provides clients for Amazon's outbound Proactive Events and
+// Reminders APIs, which let a skill push notifications to a customer outside of a
+// request/response turn.
+package proactive
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// TokenProvider supplies a bearer token for an outbound API call. It allows calls to be
+// authorized either by a running skill handler, using the request's System.APIAccessToken
+// via StaticToken, or by an out-of-band process using a LWATokenSource.
+type TokenProvider interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// StaticToken is a TokenProvider that always returns the same token, e.g. a running
+// skill handler's System.APIAccessToken.
+type StaticToken string
+
+// Token returns s.
+func (s StaticToken) Token(ctx context.Context) (string, error) {
+	return string(s), nil
+}
+
+type client struct {
+	tokens     TokenProvider
+	httpClient *http.Client
+}
+
+func newClient(tokens TokenProvider) *client {
+	return &client{tokens: tokens, httpClient: http.DefaultClient}
+}
+
+func (c *client) do(ctx context.Context, method, url string, body, out interface{}) error {
+	token, err := c.tokens.Token(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get access token: %v", err)
+	}
+
+	var bodyReader io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to marshal request: %v", err)
+		}
+		bodyReader = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequest(method, url, bodyReader)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %v", err)
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Authorization", "Bearer "+token)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request to %s failed: %v", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %d from %s", resp.StatusCode, url)
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response from %s: %v", url, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+package proactive
+
+import (
+	"context"
+	"net/http"
+)
+
+const proactiveEventsURL = "https://api.amazonalexa.com/v1/proactiveEvents"
+
+// ProactiveEventsClient submits proactive events to Amazon for delivery to a customer.
+type ProactiveEventsClient struct {
+	*client
+}
+
+// NewProactiveEventsClient returns a ProactiveEventsClient that authorizes requests using tokens.
+func NewProactiveEventsClient(tokens TokenProvider) *ProactiveEventsClient {
+	return &ProactiveEventsClient{client: newClient(tokens)}
+}
+
+// Event is submitted to the Proactive Events API. ReferenceID should be unique per event
+// instance so a later update replaces rather than duplicates it.
+type Event struct {
+	Timestamp        string           `json:"timestamp"`
+	ReferenceID      string           `json:"referenceId"`
+	ExpiryTime       string           `json:"expiryTime"`
+	Event            EventPayload     `json:"event"`
+	RelevantAudience RelevantAudience `json:"relevantAudience"`
+}
+
+// EventPayload names the built-in event schema carried by an Event, e.g.
+// EventTypeWeatherAlertActivated with a *WeatherAlertActivated payload.
+type EventPayload struct {
+	Name    string      `json:"name"`
+	Payload interface{} `json:"payload"`
+}
+
+// RelevantAudience scopes delivery of an Event to a single customer or a multicast group.
+type RelevantAudience struct {
+	Type    string                 `json:"type"` // Unicast | Multicast
+	Payload map[string]interface{} `json:"payload"`
+}
+
+// Built-in event type names for EventPayload.Name.
+const (
+	EventTypeWeatherAlertActivated         = "AMAZON.WeatherAlert.Activated"
+	EventTypeSportsEventUpdated            = "AMAZON.SportsEvent.Updated"
+	EventTypeMessageAlertActivated         = "AMAZON.MessageAlert.Activated"
+	EventTypeOrderStatusUpdated            = "AMAZON.OrderStatus.Updated"
+	EventTypeOccasionUpdated               = "AMAZON.Occasion.Updated"
+	EventTypeTrashCollectionAlertActivated = "AMAZON.TrashCollectionAlert.Activated"
+	EventTypeMediaContentAvailable         = "AMAZON.MediaContent.Available"
+	EventTypeSocialGameInviteAvailable     = "AMAZON.SocialGameInvite.Available"
+)
+
+// WeatherAlertActivated is the payload for an EventTypeWeatherAlertActivated event.
+type WeatherAlertActivated struct {
+	WeatherAlertType string `json:"weatherAlertType"`
+	Source           string `json:"source,omitempty"`
+}
+
+// SportsEventUpdated is the payload for an EventTypeSportsEventUpdated event.
+type SportsEventUpdated struct {
+	TeamHome TeamScore `json:"teamHome"`
+	TeamAway TeamScore `json:"teamAway"`
+}
+
+type TeamScore struct {
+	Name  string `json:"name"`
+	Score int    `json:"score"`
+}
+
+// MessageAlertActivated is the payload for an EventTypeMessageAlertActivated event.
+type MessageAlertActivated struct {
+	State        MessageAlertState `json:"state"`
+	MessageGroup MessageGroup      `json:"messageGroup"`
+}
+
+type MessageAlertState struct {
+	Status    string `json:"status"`    // UNREAD | FLAGGED
+	Freshness string `json:"freshness"` // NEW | OVERDUE
+}
+
+type MessageGroup struct {
+	Creator MessageCreator `json:"creator"`
+	Count   int            `json:"count"`
+}
+
+type MessageCreator struct {
+	Name string `json:"name"`
+}
+
+// OrderStatusUpdated is the payload for an EventTypeOrderStatusUpdated event.
+type OrderStatusUpdated struct {
+	State OrderState `json:"state"`
+	Order Order      `json:"order"`
+}
+
+type OrderState struct {
+	Status          string           `json:"status"` // ORDER_RECEIVED | ORDER_PREPARING | etc
+	DeliveryDetails *DeliveryDetails `json:"deliveryDetails,omitempty"`
+}
+
+type DeliveryDetails struct {
+	ExpectedArrival       string `json:"expectedArrival,omitempty"`
+	IsCodeNeededForPickup bool   `json:"isCodeNeededForPickup,omitempty"`
+}
+
+type Order struct {
+	Seller struct {
+		Name string `json:"name"`
+	} `json:"seller"`
+}
+
+// OccasionUpdated is the payload for an EventTypeOccasionUpdated event.
+type OccasionUpdated struct {
+	State    OccasionState `json:"state"`
+	Occasion Occasion      `json:"occasion"`
+}
+
+type OccasionState struct {
+	ConfirmationCode string `json:"confirmationCode,omitempty"`
+	Status           string `json:"status"` // SCHEDULED | CANCELLED | etc
+}
+
+type Occasion struct {
+	OccasionType string  `json:"occasionType"`
+	BookingTime  string  `json:"bookingTime,omitempty"`
+	Broker       *Broker `json:"broker,omitempty"`
+}
+
+type Broker struct {
+	Name string `json:"name"`
+}
+
+// TrashCollectionAlertActivated is the payload for an EventTypeTrashCollectionAlertActivated event.
+type TrashCollectionAlertActivated struct {
+	AlertType          string   `json:"alertType"`
+	TrashCollectionDay []string `json:"trashCollectionDay"`
+}
+
+// MediaContentAvailable is the payload for an EventTypeMediaContentAvailable event.
+type MediaContentAvailable struct {
+	MediaContent MediaContent `json:"mediaContent"`
+}
+
+type MediaContent struct {
+	AvailabilityType string `json:"availabilityType"` // ADDED | EXPIRING
+	MediaDetails     struct {
+		Title string `json:"title"`
+	} `json:"mediaDetails"`
+}
+
+// SocialGameInviteAvailable is the payload for an EventTypeSocialGameInviteAvailable event.
+type SocialGameInviteAvailable struct {
+	Invite                SocialGameInvite `json:"invite"`
+	RelationshipToInvitee string           `json:"relationshipToInvitee,omitempty"`
+}
+
+type SocialGameInvite struct {
+	Inviter struct {
+		Name string `json:"name"`
+	} `json:"inviter"`
+	GameName string `json:"gameName"`
+}
+
+// Create submits event for delivery to the customer or group identified by its
+// RelevantAudience.
+func (p *ProactiveEventsClient) Create(ctx context.Context, event Event) error {
+	return p.do(ctx, http.MethodPost, proactiveEventsURL, event, nil)
+}
@@ -0,0 +1,111 @@
+package proactive
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const lwaTokenURL = "https://api.amazon.com/auth/O2/token"
+
+// expiryMargin is subtracted from a token's reported lifetime so LWATokenSource refreshes
+// slightly before Amazon actually expires it.
+const expiryMargin = time.Minute
+
+// LWATokenSource is a TokenProvider that exchanges client credentials for a Login With
+// Amazon access token, caching it until shortly before it expires. Safe for concurrent use.
+type LWATokenSource struct {
+	ClientID     string
+	ClientSecret string
+	Scope        string
+	HTTPClient   *http.Client
+
+	// tokenURL overrides lwaTokenURL in tests.
+	tokenURL string
+
+	mu      sync.Mutex
+	token   string
+	expires time.Time
+}
+
+// NewLWATokenSource returns a LWATokenSource that requests scope (e.g.
+// "alexa::proactive_events" or "alexa::alerts:reminders:skill:readwrite") using the given
+// client credentials.
+func NewLWATokenSource(clientID, clientSecret, scope string) *LWATokenSource {
+	return &LWATokenSource{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		Scope:        scope,
+	}
+}
+
+// Token returns a cached access token, fetching a new one if the cache is empty or expired.
+func (l *LWATokenSource) Token(ctx context.Context) (string, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.token != "" && time.Now().Before(l.expires) {
+		return l.token, nil
+	}
+
+	token, expiresIn, err := l.fetchToken(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	l.token = token
+	l.expires = time.Now().Add(expiresIn - expiryMargin)
+
+	return l.token, nil
+}
+
+func (l *LWATokenSource) fetchToken(ctx context.Context) (string, time.Duration, error) {
+	form := url.Values{
+		"grant_type":    {"client_credentials"},
+		"client_id":     {l.ClientID},
+		"client_secret": {l.ClientSecret},
+		"scope":         {l.Scope},
+	}
+
+	tokenURL := l.tokenURL
+	if tokenURL == "" {
+		tokenURL = lwaTokenURL
+	}
+
+	req, err := http.NewRequest(http.MethodPost, tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to create token request: %v", err)
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	httpClient := l.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", 0, fmt.Errorf("token request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", 0, fmt.Errorf("unexpected status %d from token endpoint", resp.StatusCode)
+	}
+
+	var body struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", 0, fmt.Errorf("failed to decode token response: %v", err)
+	}
+
+	return body.AccessToken, time.Duration(body.ExpiresIn) * time.Second, nil
+}
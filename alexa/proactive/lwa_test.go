@@ -0,0 +1,93 @@
+package proactive
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newTokenServer(t *testing.T, expiresIn int) (*httptest.Server, *int) {
+	t.Helper()
+
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		_ = json.NewEncoder(w).Encode(struct {
+			AccessToken string `json:"access_token"`
+			ExpiresIn   int    `json:"expires_in"`
+		}{
+			AccessToken: fmt.Sprintf("token-%d", calls),
+			ExpiresIn:   expiresIn,
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &calls
+}
+
+func TestLWATokenSourceCachesUntilExpiry(t *testing.T) {
+	server, calls := newTokenServer(t, 3600)
+
+	src := NewLWATokenSource("id", "secret", "scope")
+	src.tokenURL = server.URL
+
+	first, err := src.Token(context.Background())
+	if err != nil {
+		t.Fatalf("Token() returned error: %v", err)
+	}
+
+	second, err := src.Token(context.Background())
+	if err != nil {
+		t.Fatalf("Token() returned error: %v", err)
+	}
+
+	if first != second {
+		t.Errorf("Token() = %q then %q, want cached token reused", first, second)
+	}
+	if *calls != 1 {
+		t.Errorf("token endpoint called %d times, want 1", *calls)
+	}
+}
+
+func TestLWATokenSourceRefreshesAfterExpiry(t *testing.T) {
+	// expiresIn is smaller than expiryMargin, so the cached token is already considered
+	// expired as soon as it's fetched and every call refetches.
+	server, calls := newTokenServer(t, 10)
+
+	src := NewLWATokenSource("id", "secret", "scope")
+	src.tokenURL = server.URL
+
+	first, err := src.Token(context.Background())
+	if err != nil {
+		t.Fatalf("Token() returned error: %v", err)
+	}
+
+	second, err := src.Token(context.Background())
+	if err != nil {
+		t.Fatalf("Token() returned error: %v", err)
+	}
+
+	if first == second {
+		t.Errorf("Token() returned %q both times, want a refetch once the margin is exceeded", first)
+	}
+	if *calls != 2 {
+		t.Errorf("token endpoint called %d times, want 2", *calls)
+	}
+}
+
+func TestLWATokenSourceErrorsOnBadStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	t.Cleanup(server.Close)
+
+	src := NewLWATokenSource("id", "secret", "scope")
+	src.tokenURL = server.URL
+
+	if _, err := src.Token(context.Background()); err == nil {
+		t.Error("Token() expected error for non-200 response, got nil")
+	}
+}
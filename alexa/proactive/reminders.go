@@ -0,0 +1,124 @@
+package proactive
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+const remindersURL = "https://api.amazonalexa.com/v1/alerts/reminders"
+
+// RemindersClient manages reminders scheduled on behalf of a customer.
+type RemindersClient struct {
+	*client
+}
+
+// NewRemindersClient returns a RemindersClient that authorizes requests using tokens.
+func NewRemindersClient(tokens TokenProvider) *RemindersClient {
+	return &RemindersClient{client: newClient(tokens)}
+}
+
+// Reminder describes a reminder to create or update.
+type Reminder struct {
+	RequestTime      string            `json:"requestTime,omitempty"`
+	Trigger          ReminderTrigger   `json:"trigger"`
+	AlertInfo        AlertInfo         `json:"alertInfo"`
+	PushNotification *PushNotification `json:"pushNotification,omitempty"`
+}
+
+// ReminderTrigger schedules a reminder at an absolute time, or relative to when it was
+// created, optionally recurring.
+type ReminderTrigger struct {
+	Type            string      `json:"type"` // SCHEDULED_ABSOLUTE | SCHEDULED_RELATIVE
+	ScheduledTime   string      `json:"scheduledTime,omitempty"`
+	OffsetInSeconds int         `json:"offsetInSeconds,omitempty"`
+	TimeZoneID      string      `json:"timeZoneId,omitempty"`
+	Recurrence      *Recurrence `json:"recurrence,omitempty"`
+}
+
+// Recurrence repeats a reminder according to an iCalendar-style freq/byDay/interval rule.
+type Recurrence struct {
+	Freq          string   `json:"freq,omitempty"` // DAILY | WEEKLY
+	ByDay         []string `json:"byDay,omitempty"`
+	Interval      int      `json:"interval,omitempty"`
+	StartDateTime string   `json:"startDateTime,omitempty"`
+	EndDateTime   string   `json:"endDateTime,omitempty"`
+}
+
+// AlertInfo carries what Alexa should say when the reminder fires.
+type AlertInfo struct {
+	SpokenInfo SpokenInfo `json:"spokenInfo"`
+}
+
+type SpokenInfo struct {
+	Content []SpokenText `json:"content"`
+}
+
+type SpokenText struct {
+	Locale string `json:"locale"`
+	Text   string `json:"text"`
+}
+
+// PushNotification controls whether a companion app push notification accompanies the reminder.
+type PushNotification struct {
+	Status string `json:"status"` // ENABLED | DISABLED
+}
+
+// ReminderResponse is returned by the Reminders API for a single reminder.
+type ReminderResponse struct {
+	AlertToken       string            `json:"alertToken"`
+	CreatedTime      string            `json:"createdTime"`
+	UpdatedTime      string            `json:"updatedTime"`
+	Status           string            `json:"status"`
+	Trigger          ReminderTrigger   `json:"trigger"`
+	AlertInfo        AlertInfo         `json:"alertInfo"`
+	PushNotification *PushNotification `json:"pushNotification,omitempty"`
+}
+
+// ReminderList is a page of reminders returned by List.
+type ReminderList struct {
+	TotalCount string             `json:"totalCount"`
+	Alerts     []ReminderResponse `json:"alerts"`
+	Links      map[string]string  `json:"links,omitempty"`
+}
+
+// Create schedules reminder and returns the created reminder, including its alert token.
+func (r *RemindersClient) Create(ctx context.Context, reminder Reminder) (*ReminderResponse, error) {
+	var out ReminderResponse
+	if err := r.do(ctx, http.MethodPost, remindersURL, reminder, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Get returns the reminder identified by alertToken.
+func (r *RemindersClient) Get(ctx context.Context, alertToken string) (*ReminderResponse, error) {
+	var out ReminderResponse
+	if err := r.do(ctx, http.MethodGet, remindersURL+"/"+url.PathEscape(alertToken), nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Update replaces the reminder identified by alertToken with reminder.
+func (r *RemindersClient) Update(ctx context.Context, alertToken string, reminder Reminder) (*ReminderResponse, error) {
+	var out ReminderResponse
+	if err := r.do(ctx, http.MethodPut, remindersURL+"/"+url.PathEscape(alertToken), reminder, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Delete cancels the reminder identified by alertToken.
+func (r *RemindersClient) Delete(ctx context.Context, alertToken string) error {
+	return r.do(ctx, http.MethodDelete, remindersURL+"/"+url.PathEscape(alertToken), nil, nil)
+}
+
+// List returns all reminders scheduled for the customer.
+func (r *RemindersClient) List(ctx context.Context) (*ReminderList, error) {
+	var out ReminderList
+	if err := r.do(ctx, http.MethodGet, remindersURL, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,66 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/mctofu/alexa-skill/alexa"
+)
+
+// Device address permissions accepted by alexa.PermissionsConsentCard.
+const (
+	PermissionFullAddress   = "read::alexa:device:all:address"
+	PermissionCountryPostal = "read::alexa:device:all:address:country_and_postal_code"
+)
+
+// DeviceAddressClient fetches the address a customer has configured for their device.
+type DeviceAddressClient struct {
+	*client
+}
+
+// NewDeviceAddressClient returns a DeviceAddressClient that calls the API endpoint and
+// access token carried on c.
+func NewDeviceAddressClient(c *alexa.Context) *DeviceAddressClient {
+	return &DeviceAddressClient{client: newClient(c)}
+}
+
+// Address is a customer's full device address.
+type Address struct {
+	AddressLine1     string `json:"addressLine1"`
+	AddressLine2     string `json:"addressLine2"`
+	AddressLine3     string `json:"addressLine3"`
+	City             string `json:"city"`
+	StateOrRegion    string `json:"stateOrRegion"`
+	DistrictOrCounty string `json:"districtOrCounty"`
+	PostalCode       string `json:"postalCode"`
+	CountryCode      string `json:"countryCode"`
+}
+
+// Full returns the complete address configured for deviceID. Requires the
+// PermissionFullAddress permission.
+func (d *DeviceAddressClient) Full(ctx context.Context, deviceID string) (*Address, error) {
+	var addr Address
+	path := fmt.Sprintf("/v1/devices/%s/settings/address", url.PathEscape(deviceID))
+	if err := d.get(ctx, path, PermissionFullAddress, &addr); err != nil {
+		return nil, err
+	}
+	return &addr, nil
+}
+
+// CountryPostalAddress is a customer's coarse device address.
+type CountryPostalAddress struct {
+	CountryCode string `json:"countryCode"`
+	PostalCode  string `json:"postalCode"`
+}
+
+// CountryPostal returns the country and postal code configured for deviceID. Requires the
+// PermissionCountryPostal permission.
+func (d *DeviceAddressClient) CountryPostal(ctx context.Context, deviceID string) (*CountryPostalAddress, error) {
+	var addr CountryPostalAddress
+	path := fmt.Sprintf("/v1/devices/%s/settings/address/countryAndPostalCode", url.PathEscape(deviceID))
+	if err := d.get(ctx, path, PermissionCountryPostal, &addr); err != nil {
+		return nil, err
+	}
+	return &addr, nil
+}
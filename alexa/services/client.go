@@ -0,0 +1,71 @@
+// Package services provides typed clients for the Alexa device and customer APIs that
+// are reachable via System.APIEndpoint using the request's System.APIAccessToken.
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/mctofu/alexa-skill/alexa"
+)
+
+// PermissionsError indicates the caller lacks a permission needed to access an endpoint.
+// Handlers can respond by asking the user to grant it with a card built by
+// alexa.PermissionsConsentCard.
+type PermissionsError struct {
+	Permission string
+}
+
+func (p PermissionsError) Error() string {
+	return fmt.Sprintf("missing permission: %s", p.Permission)
+}
+
+type client struct {
+	baseURL     string
+	accessToken string
+	httpClient  *http.Client
+}
+
+func newClient(c *alexa.Context) *client {
+	return &client{
+		baseURL:     c.System.APIEndpoint,
+		accessToken: c.System.APIAccessToken,
+		httpClient:  http.DefaultClient,
+	}
+}
+
+// get issues an authenticated GET to path and decodes a JSON response into out. permission
+// identifies the consent permission to report if the device responds 403.
+func (c *client) get(ctx context.Context, path, permission string, out interface{}) error {
+	req, err := http.NewRequest(http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %v", err)
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Authorization", "Bearer "+c.accessToken)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request to %s failed: %v", path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusForbidden {
+		return PermissionsError{Permission: permission}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d from %s", resp.StatusCode, path)
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response from %s: %v", path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package services
+
+import (
+	"context"
+
+	"github.com/mctofu/alexa-skill/alexa"
+)
+
+// Customer profile permissions accepted by alexa.PermissionsConsentCard.
+const (
+	PermissionProfileName         = "alexa::profile:name:read"
+	PermissionProfileEmail        = "alexa::profile:email:read"
+	PermissionProfileMobileNumber = "alexa::profile:mobile_number:read"
+)
+
+// CustomerProfileClient fetches the signed-in customer's profile information.
+type CustomerProfileClient struct {
+	*client
+}
+
+// NewCustomerProfileClient returns a CustomerProfileClient that calls the API endpoint and
+// access token carried on c.
+func NewCustomerProfileClient(c *alexa.Context) *CustomerProfileClient {
+	return &CustomerProfileClient{client: newClient(c)}
+}
+
+// Name returns the customer's given name. Requires the PermissionProfileName permission.
+func (p *CustomerProfileClient) Name(ctx context.Context) (string, error) {
+	var name string
+	if err := p.get(ctx, "/v2/accounts/~current/settings/Profile.givenName", PermissionProfileName, &name); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+// Email returns the customer's email address. Requires the PermissionProfileEmail permission.
+func (p *CustomerProfileClient) Email(ctx context.Context) (string, error) {
+	var email string
+	if err := p.get(ctx, "/v2/accounts/~current/settings/Profile.email", PermissionProfileEmail, &email); err != nil {
+		return "", err
+	}
+	return email, nil
+}
+
+// MobileNumber is a customer's verified phone number.
+type MobileNumber struct {
+	CountryCode string `json:"countryCode"`
+	PhoneNumber string `json:"phoneNumber"`
+}
+
+// MobileNumber returns the customer's mobile number. Requires the
+// PermissionProfileMobileNumber permission.
+func (p *CustomerProfileClient) MobileNumber(ctx context.Context) (*MobileNumber, error) {
+	var number MobileNumber
+	if err := p.get(ctx, "/v2/accounts/~current/settings/Profile.mobileNumber", PermissionProfileMobileNumber, &number); err != nil {
+		return nil, err
+	}
+	return &number, nil
+}
@@ -13,19 +13,49 @@ type Request struct {
 	RequestID            string `json:"requestId"`
 	Timestamp            string `json:"timestamp"`
 	Intent               Intent `json:"intent"`
+	DialogState          string `json:"dialogState,omitempty"`
 	Locale               string `json:"locale"`
 	Token                string `json:"token"`
 	OffsetInMilliseconds int64  `json:"offsetInMilliseconds"`
 }
 
 type Intent struct {
-	Name  string                `json:"name"`
-	Slots map[string]IntentSlot `json:"slots"`
+	Name               string                `json:"name"`
+	ConfirmationStatus string                `json:"confirmationStatus,omitempty"`
+	Slots              map[string]IntentSlot `json:"slots"`
 }
 
 type IntentSlot struct {
-	Name  string `json:"name"`
-	Value string `json:"value"`
+	Name               string       `json:"name"`
+	Value              string       `json:"value"`
+	ConfirmationStatus string       `json:"confirmationStatus,omitempty"`
+	Resolutions        *Resolutions `json:"resolutions,omitempty"`
+}
+
+// Resolutions carries the entity resolution results for a slot across each configured
+// slot type authority (e.g. a custom slot type backed by an entity resolution catalog).
+type Resolutions struct {
+	ResolutionsPerAuthority []ResolutionPerAuthority `json:"resolutionsPerAuthority,omitempty"`
+}
+
+type ResolutionPerAuthority struct {
+	Authority string            `json:"authority"`
+	Status    ResolutionStatus  `json:"status"`
+	Values    []ResolutionValue `json:"values,omitempty"`
+}
+
+type ResolutionStatus struct {
+	Code string `json:"code"`
+}
+
+// ResolutionValue wraps the matched id/name pair for a single entity resolution result.
+type ResolutionValue struct {
+	Value ResolutionValueData `json:"value"`
+}
+
+type ResolutionValueData struct {
+	Name string `json:"name"`
+	ID   string `json:"id"`
 }
 
 type Session struct {
@@ -56,10 +86,11 @@ type Device struct {
 }
 
 type System struct {
-	Application RequestApplication `json:"application"`
-	User        User               `json:"user"`
-	Device      Device             `json:"device"`
-	APIEndpoint string             `json:"apiEndpoint"`
+	Application    RequestApplication `json:"application"`
+	User           User               `json:"user"`
+	Device         Device             `json:"device"`
+	APIEndpoint    string             `json:"apiEndpoint"`
+	APIAccessToken string             `json:"apiAccessToken"`
 }
 
 type Context struct {
@@ -100,11 +131,12 @@ type OutputSpeech struct {
 }
 
 type Card struct {
-	Type    string `json:"type"`
-	Title   string `json:"title,omitempty"`
-	Content string `json:"content,omitempty"`
-	Text    string `json:"text,omitempty"`
-	Image   *Image `json:"image,omitempty"`
+	Type        string   `json:"type"`
+	Title       string   `json:"title,omitempty"`
+	Content     string   `json:"content,omitempty"`
+	Text        string   `json:"text,omitempty"`
+	Image       *Image   `json:"image,omitempty"`
+	Permissions []string `json:"permissions,omitempty"`
 }
 
 type Image struct {
@@ -117,15 +149,40 @@ type Reprompt struct {
 }
 
 type Directive struct {
-	Type         string `json:"type"`
-	PlayBehavior string `json:"playBehavior,omitempty"`
-	AudioItem    *struct {
-		Stream *Stream `json:"stream,omitempty"`
-	} `json:"audioItem,omitempty"`
+	Type          string     `json:"type"`
+	PlayBehavior  string     `json:"playBehavior,omitempty"`
+	AudioItem     *AudioItem `json:"audioItem,omitempty"`
+	ClearBehavior string     `json:"clearBehavior,omitempty"`
+	UpdatedIntent *Intent    `json:"updatedIntent,omitempty"`
+	SlotToElicit  string     `json:"slotToElicit,omitempty"`
+	SlotToConfirm string     `json:"slotToConfirm,omitempty"`
+}
+
+// AudioItem carries the stream and display metadata for an AudioPlayer.Play directive.
+type AudioItem struct {
+	Stream   *Stream         `json:"stream,omitempty"`
+	Metadata *StreamMetadata `json:"metadata,omitempty"`
+}
+
+// StreamMetadata is displayed by devices with a screen while a stream plays.
+type StreamMetadata struct {
+	Title           string    `json:"title,omitempty"`
+	Subtitle        string    `json:"subtitle,omitempty"`
+	Art             *ImageSet `json:"art,omitempty"`
+	BackgroundImage *ImageSet `json:"backgroundImage,omitempty"`
+}
+
+type ImageSet struct {
+	Sources []ImageSource `json:"sources,omitempty"`
+}
+
+type ImageSource struct {
+	URL string `json:"url"`
 }
 
 type Stream struct {
-	Token                string `json:"token"`
-	URL                  string `json:"url"`
-	OffsetInMilliseconds int    `json:"offsetInMilliseconds"`
+	Token                 string `json:"token"`
+	URL                   string `json:"url"`
+	OffsetInMilliseconds  int    `json:"offsetInMilliseconds"`
+	ExpectedPreviousToken string `json:"expectedPreviousToken,omitempty"`
 }
@@ -0,0 +1,123 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// defaultMaxCertCacheEntries bounds CachingCertReader's cache so that an unauthenticated
+// caller supplying unbounded distinct (but otherwise valid-looking) cert chain URLs can't
+// grow it without limit.
+const defaultMaxCertCacheEntries = 16
+
+// CachingCertReader wraps a CertReader and memoizes the cert chain fetched for each url,
+// bounding the cached lifetime by the chain's earliest NotAfter so an expiring cert is
+// refetched instead of served stale. The cache is capped at MaxEntries, evicting expired
+// entries first and then the entry nearest to expiring. Safe for concurrent use.
+type CachingCertReader struct {
+	reader CertReader
+
+	// MaxEntries caps the number of distinct urls cached at once. Zero uses
+	// defaultMaxCertCacheEntries.
+	MaxEntries int
+
+	mu      sync.Mutex
+	entries map[string]certCacheEntry
+}
+
+type certCacheEntry struct {
+	contents []byte
+	expires  time.Time
+}
+
+// NewCachingCertReader returns a CachingCertReader that fetches cache misses using reader.
+func NewCachingCertReader(reader CertReader) *CachingCertReader {
+	return &CachingCertReader{
+		reader:  reader,
+		entries: make(map[string]certCacheEntry),
+	}
+}
+
+// Read returns the cached cert chain for url if present and unexpired, otherwise it
+// fetches and caches it using the wrapped CertReader.
+func (c *CachingCertReader) Read(ctx context.Context, url string) ([]byte, error) {
+	now := time.Now()
+
+	c.mu.Lock()
+	entry, ok := c.entries[url]
+	c.mu.Unlock()
+	if ok && now.Before(entry.expires) {
+		return entry.contents, nil
+	}
+
+	contents, err := c.reader(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	expires, err := certChainExpiry(contents)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.store(url, certCacheEntry{contents: contents, expires: expires}, now)
+	c.mu.Unlock()
+
+	return contents, nil
+}
+
+func (c *CachingCertReader) maxEntries() int {
+	if c.MaxEntries > 0 {
+		return c.MaxEntries
+	}
+	return defaultMaxCertCacheEntries
+}
+
+// store inserts entry for url, making room first if the cache is full: expired entries
+// are evicted, and if that isn't enough, the entry nearest to expiring is evicted too.
+func (c *CachingCertReader) store(url string, entry certCacheEntry, now time.Time) {
+	if _, exists := c.entries[url]; !exists && len(c.entries) >= c.maxEntries() {
+		c.evict(now)
+	}
+	c.entries[url] = entry
+}
+
+func (c *CachingCertReader) evict(now time.Time) {
+	for key, entry := range c.entries {
+		if now.After(entry.expires) {
+			delete(c.entries, key)
+		}
+	}
+	if len(c.entries) < c.maxEntries() {
+		return
+	}
+
+	var soonestKey string
+	var soonestExpires time.Time
+	for key, entry := range c.entries {
+		if soonestKey == "" || entry.expires.Before(soonestExpires) {
+			soonestKey, soonestExpires = key, entry.expires
+		}
+	}
+	delete(c.entries, soonestKey)
+}
+
+// certChainExpiry returns the earliest NotAfter across the chain so the cache entry
+// never outlives the first certificate to expire.
+func certChainExpiry(pemData []byte) (time.Time, error) {
+	chain, err := parseCertChain(pemData)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var expires time.Time
+	for i, cert := range chain {
+		if i == 0 || cert.NotAfter.Before(expires) {
+			expires = cert.NotAfter
+		}
+	}
+
+	return expires, nil
+}
@@ -5,7 +5,6 @@ import (
 	"context"
 	"crypto"
 	"crypto/rsa"
-	"crypto/sha1"
 	"crypto/x509"
 	"encoding/base64"
 	"encoding/json"
@@ -59,21 +58,65 @@ func NewAppHandler(app alexa.AppHandler) http.HandlerFunc {
 	}
 }
 
-// CertReader returns the contents of the cert at the provided url
+// CertReader returns the contents of the cert chain at the provided url
 type CertReader func(ctx context.Context, url string) ([]byte, error)
 
+// Verifier validates that a http request originated from the alexa skill service by
+// checking its signature against the cert chain referenced in the request headers.
+// The SHA-256 based Signature-256/SignatureCertChainUrl-256 header pair is preferred;
+// the legacy SHA-1 Signature/SignatureCertChainUrl pair is used as a fallback for
+// requests that haven't been migrated to the newer scheme, unless RequireSHA256 is set.
+type Verifier struct {
+	CertReader CertReader
+
+	// RequireSHA256 rejects requests that don't carry the Signature-256/
+	// SignatureCertChainUrl-256 header pair instead of falling back to legacy SHA-1.
+	RequireSHA256 bool
+
+	// Roots overrides the certificate pool used to verify the cert chain. A nil Roots
+	// verifies against the system root pool, which is correct for production use; tests
+	// can supply their own pool.
+	Roots *x509.CertPool
+}
+
+// NewVerifier returns a Verifier that fetches cert chains using certReader. Wrap certReader
+// with NewCachingCertReader to avoid refetching the chain on every request.
+func NewVerifier(certReader CertReader) *Verifier {
+	return &Verifier{CertReader: certReader}
+}
+
+// Verify checks the signature and cert chain of r and returns a reader that replays r.Body.
+func (v *Verifier) Verify(r *http.Request) (io.Reader, error) {
+	sigHeader, certURLHeader, hash := "Signature-256", "SignatureCertChainUrl-256", crypto.SHA256
+	if r.Header.Get(sigHeader) == "" {
+		if v.RequireSHA256 {
+			return nil, errors.New("missing Signature-256 header")
+		}
+		sigHeader, certURLHeader, hash = "Signature", "SignatureCertChainUrl", crypto.SHA1
+	}
+
+	cert, err := v.readValidateCertificate(r, certURLHeader)
+	if err != nil {
+		return nil, fmt.Errorf("certificate validation failed: %v", err)
+	}
+
+	body, err := readValidateBody(r, sigHeader, hash, cert)
+	if err != nil {
+		return nil, fmt.Errorf("signature validation failed: %v", err)
+	}
+
+	return body, nil
+}
+
 // NewValidatingHandler wraps a http.Handler and validates the request is an authentic request from the
 // alexa skill service
 func NewValidatingHandler(alexaHandler http.Handler, certReader CertReader) http.HandlerFunc {
+	v := NewVerifier(certReader)
 	return func(w http.ResponseWriter, r *http.Request) {
-		cert, err := readValidateCertificate(r, certReader, time.Now())
+		body, err := v.Verify(r)
 		if err != nil {
-			http.Error(w, fmt.Sprintf("Certificate validation failed: %v", err), http.StatusUnauthorized)
-		}
-
-		body, err := readValidateBody(r, cert)
-		if err != nil {
-			http.Error(w, fmt.Sprintf("Signature validation failed: %v", err), http.StatusUnauthorized)
+			http.Error(w, fmt.Sprintf("Request validation failed: %v", err), http.StatusUnauthorized)
+			return
 		}
 
 		// restore request body
@@ -82,60 +125,87 @@ func NewValidatingHandler(alexaHandler http.Handler, certReader CertReader) http
 	}
 }
 
-func readValidateCertificate(r *http.Request, certReader CertReader, now time.Time) (*x509.Certificate, error) {
-	certURL := r.Header.Get("SignatureCertChainUrl")
+func (v *Verifier) readValidateCertificate(r *http.Request, certURLHeader string) (*x509.Certificate, error) {
+	certURL := r.Header.Get(certURLHeader)
 
 	err := verifyCertURL(certURL)
 	if err != nil {
 		return nil, err
 	}
 
-	certContents, err := certReader(r.Context(), certURL)
+	certContents, err := v.CertReader(r.Context(), certURL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read cert at %s: %v", certURL, err)
 	}
 
-	block, _ := pem.Decode(certContents)
-	if block == nil {
-		return nil, errors.New("failed to parse certificate PEM")
-	}
-
-	cert, err := x509.ParseCertificate(block.Bytes)
+	chain, err := parseCertChain(certContents)
 	if err != nil {
 		return nil, err
 	}
+	if len(chain) == 0 {
+		return nil, errors.New("no certificates found in chain")
+	}
+	leaf := chain[0]
 
-	if now.Unix() < cert.NotBefore.Unix() || now.Unix() > cert.NotAfter.Unix() {
-		return nil, errors.New("certificate expired")
+	intermediates := x509.NewCertPool()
+	for _, cert := range chain[1:] {
+		intermediates.AddCert(cert)
 	}
 
-	foundName := false
-	for _, altName := range cert.Subject.Names {
-		if altName.Value == "echo-api.amazon.com" {
-			foundName = true
-		}
+	if _, err := leaf.Verify(x509.VerifyOptions{
+		Roots:         v.Roots,
+		Intermediates: intermediates,
+		CurrentTime:   time.Now(),
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}); err != nil {
+		return nil, fmt.Errorf("certificate chain verification failed: %v", err)
 	}
 
-	if !foundName {
-		return nil, errors.New("certification invalid")
+	if err := leaf.VerifyHostname("echo-api.amazon.com"); err != nil {
+		return nil, fmt.Errorf("certificate name verification failed: %v", err)
 	}
 
-	return cert, nil
+	return leaf, nil
 }
 
-func readValidateBody(r *http.Request, cert *x509.Certificate) (io.Reader, error) {
-	publicKey := cert.PublicKey
-	encryptedSig, _ := base64.StdEncoding.DecodeString(r.Header.Get("Signature"))
+// parseCertChain parses one or more concatenated PEM encoded certificates, leaf first.
+func parseCertChain(pemData []byte) ([]*x509.Certificate, error) {
+	var chain []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, pemData = pem.Decode(pemData)
+		if block == nil {
+			break
+		}
 
-	var bodyBuf bytes.Buffer
-	hash := sha1.New()
-	_, err := io.Copy(hash, io.TeeReader(r.Body, &bodyBuf))
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse certificate PEM: %v", err)
+		}
+		chain = append(chain, cert)
+	}
+
+	return chain, nil
+}
+
+func readValidateBody(r *http.Request, sigHeader string, hash crypto.Hash, cert *x509.Certificate) (io.Reader, error) {
+	publicKey, ok := cert.PublicKey.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("certificate public key is not RSA")
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(r.Header.Get(sigHeader))
 	if err != nil {
+		return nil, fmt.Errorf("invalid %s header: %v", sigHeader, err)
+	}
+
+	var bodyBuf bytes.Buffer
+	h := hash.New()
+	if _, err := io.Copy(h, io.TeeReader(r.Body, &bodyBuf)); err != nil {
 		return nil, err
 	}
 
-	err = rsa.VerifyPKCS1v15(publicKey.(*rsa.PublicKey), crypto.SHA1, hash.Sum(nil), encryptedSig)
-	if err != nil {
+	if err := rsa.VerifyPKCS1v15(publicKey, hash, h.Sum(nil), sig); err != nil {
 		return nil, errors.New("signature match failed")
 	}
 
@@ -166,7 +236,10 @@ func HTTPCertReader(ctx context.Context, certURL string) ([]byte, error) {
 }
 
 func verifyCertURL(path string) error {
-	link, _ := url.Parse(path)
+	link, err := url.Parse(path)
+	if err != nil {
+		return fmt.Errorf("invalid cert url: %v", err)
+	}
 
 	if link.Scheme != "https" {
 		return errors.New("cert url not https")
@@ -0,0 +1,229 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// newTestChain generates a self-signed CA and a leaf certificate for echo-api.amazon.com
+// signed by it, returning a root pool containing the CA and the PEM encoded leaf cert.
+func newTestChain(t *testing.T) (*x509.CertPool, []byte, *rsa.PrivateKey) {
+	t.Helper()
+
+	caKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate CA key: %v", err)
+	}
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatalf("failed to create CA cert: %v", err)
+	}
+	caCert, err := x509.ParseCertificate(caDER)
+	if err != nil {
+		t.Fatalf("failed to parse CA cert: %v", err)
+	}
+
+	leafKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate leaf key: %v", err)
+	}
+	leafTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "echo-api.amazon.com"},
+		DNSNames:     []string{"echo-api.amazon.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+	leafDER, err := x509.CreateCertificate(rand.Reader, leafTemplate, caCert, &leafKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatalf("failed to create leaf cert: %v", err)
+	}
+	leafPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leafDER})
+
+	roots := x509.NewCertPool()
+	roots.AddCert(caCert)
+
+	return roots, leafPEM, leafKey
+}
+
+func signRequest(t *testing.T, req *http.Request, body []byte, key *rsa.PrivateKey, certURL string, sha256 bool) {
+	t.Helper()
+
+	hash := crypto.SHA1
+	sigHeader, certURLHeader := "Signature", "SignatureCertChainUrl"
+	if sha256 {
+		hash = crypto.SHA256
+		sigHeader, certURLHeader = "Signature-256", "SignatureCertChainUrl-256"
+	}
+
+	h := hash.New()
+	if _, err := h.Write(body); err != nil {
+		t.Fatalf("failed to hash body: %v", err)
+	}
+
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, hash, h.Sum(nil))
+	if err != nil {
+		t.Fatalf("failed to sign body: %v", err)
+	}
+
+	req.Header.Set(sigHeader, base64.StdEncoding.EncodeToString(sig))
+	req.Header.Set(certURLHeader, certURL)
+}
+
+func newSignedRequest(t *testing.T, body []byte, key *rsa.PrivateKey, certURL string, sha256 bool) *http.Request {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+	signRequest(t, req, body, key, certURL, sha256)
+	return req
+}
+
+func readAll(t *testing.T, r io.Reader) []byte {
+	t.Helper()
+	b, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("failed to read body: %v", err)
+	}
+	return b
+}
+
+func TestVerifierVerifySHA256(t *testing.T) {
+	roots, leafPEM, leafKey := newTestChain(t)
+	certURL := "https://s3.amazonaws.com/echo.api/cert.pem"
+
+	v := &Verifier{
+		Roots: roots,
+		CertReader: func(ctx context.Context, url string) ([]byte, error) {
+			if url != certURL {
+				t.Fatalf("unexpected cert url: %s", url)
+			}
+			return leafPEM, nil
+		},
+	}
+
+	body := []byte(`{"hello":"world"}`)
+	req := newSignedRequest(t, body, leafKey, certURL, true)
+
+	replayed, err := v.Verify(req)
+	if err != nil {
+		t.Fatalf("Verify() returned error: %v", err)
+	}
+	if got := readAll(t, replayed); !bytes.Equal(got, body) {
+		t.Errorf("Verify() body = %q, want %q", got, body)
+	}
+}
+
+func TestVerifierVerifySHA1Fallback(t *testing.T) {
+	roots, leafPEM, leafKey := newTestChain(t)
+	certURL := "https://s3.amazonaws.com/echo.api/cert.pem"
+
+	v := &Verifier{
+		Roots: roots,
+		CertReader: func(ctx context.Context, url string) ([]byte, error) {
+			return leafPEM, nil
+		},
+	}
+
+	body := []byte(`{"hello":"world"}`)
+	req := newSignedRequest(t, body, leafKey, certURL, false)
+
+	replayed, err := v.Verify(req)
+	if err != nil {
+		t.Fatalf("Verify() returned error: %v", err)
+	}
+	if got := readAll(t, replayed); !bytes.Equal(got, body) {
+		t.Errorf("Verify() body = %q, want %q", got, body)
+	}
+}
+
+func TestVerifierVerifyRequireSHA256RejectsLegacy(t *testing.T) {
+	roots, leafPEM, leafKey := newTestChain(t)
+	certURL := "https://s3.amazonaws.com/echo.api/cert.pem"
+
+	v := &Verifier{
+		Roots:         roots,
+		RequireSHA256: true,
+		CertReader: func(ctx context.Context, url string) ([]byte, error) {
+			return leafPEM, nil
+		},
+	}
+
+	body := []byte(`{"hello":"world"}`)
+	req := newSignedRequest(t, body, leafKey, certURL, false)
+
+	if _, err := v.Verify(req); err == nil {
+		t.Error("Verify() expected error for legacy signature when RequireSHA256 is set")
+	}
+}
+
+func TestVerifierVerifyMixedCaseHeaders(t *testing.T) {
+	roots, leafPEM, leafKey := newTestChain(t)
+	certURL := "https://s3.amazonaws.com/echo.api/cert.pem"
+
+	v := &Verifier{
+		Roots: roots,
+		CertReader: func(ctx context.Context, url string) ([]byte, error) {
+			return leafPEM, nil
+		},
+	}
+
+	body := []byte(`{"hello":"world"}`)
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+
+	hash := crypto.SHA256
+	h := hash.New()
+	h.Write(body)
+	sig, err := rsa.SignPKCS1v15(rand.Reader, leafKey, hash, h.Sum(nil))
+	if err != nil {
+		t.Fatalf("failed to sign body: %v", err)
+	}
+
+	// http.Header canonicalizes keys on Set/Get, so mixed-case headers as sent by some
+	// clients still resolve to the same canonical header.
+	req.Header.Set("signature-256", base64.StdEncoding.EncodeToString(sig))
+	req.Header.Set("signaturecertchainurl-256", certURL)
+
+	if _, err := v.Verify(req); err != nil {
+		t.Fatalf("Verify() returned error: %v", err)
+	}
+}
+
+func TestVerifierVerifyMalformedCertURL(t *testing.T) {
+	v := &Verifier{
+		CertReader: func(ctx context.Context, url string) ([]byte, error) {
+			t.Fatal("CertReader should not be called for a malformed cert url")
+			return nil, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(nil))
+	req.Header.Set("Signature-256", "invalid")
+	req.Header.Set("SignatureCertChainUrl-256", "http://foo.com/%zz")
+
+	if _, err := v.Verify(req); err == nil {
+		t.Error("Verify() expected error for malformed cert url, got nil")
+	}
+}